@@ -38,9 +38,11 @@ var Cmd = &base.Command{
 }
 
 var (
-	flagOutput = flag.String("o", "", "build output file")
-	_          = flag.Bool("v", false, "print verbose information")
-	flag       = &Cmd.Flag
+	flagOutput  = flag.String("o", "", "build output file")
+	flagLdFlags = flag.String("ldflags", "", "arguments to pass on each link invocation")
+	flagLinker  = flag.String("linker", os.Getenv("LLGO_LINKER"), "linker for clang to use, e.g. lld or mold; defaults to $LLGO_LINKER")
+	_           = flag.Bool("v", false, "print verbose information")
+	flag        = &Cmd.Flag
 )
 
 func init() {
@@ -75,6 +77,14 @@ func runCmd(cmd *base.Command, args []string) {
 		}
 		confCmd.Output = output
 	}
+	if *flagLdFlags != "" {
+		ldflags, err := gocmd.SplitQuoted(*flagLdFlags)
+		if err != nil {
+			log.Panicln("invalid -ldflags:", err)
+		}
+		confCmd.LdFlags = ldflags
+	}
+	confCmd.Linker = *flagLinker
 	build(proj, conf, confCmd)
 }
 
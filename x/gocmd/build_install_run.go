@@ -18,8 +18,23 @@ package gocmd
 
 // -----------------------------------------------------------------------------
 
+// BuildConfig holds the settings for building and linking. The link
+// settings below are recorded ahead of the link step, which is not
+// implemented yet; nothing reads them so far.
 type BuildConfig struct {
 	Output string
+
+	// ClangPath is the clang binary the link step should run, e.g.
+	// "/usr/lib/llvm-17/bin/clang". Empty means clang is looked up in PATH.
+	// The link step that runs clang is not implemented yet.
+	ClangPath string
+
+	// Linker names the linker the link step should use, e.g. "lld" or
+	// "mold". Empty means clang's default linker.
+	Linker string
+
+	// LdFlags are extra arguments for the link command line.
+	LdFlags []string
 }
 
 // -----------------------------------------------------------------------------
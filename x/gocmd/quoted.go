@@ -0,0 +1,65 @@
+/*
+ * Copyright (c) 2023 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gocmd
+
+import (
+	"fmt"
+)
+
+// -----------------------------------------------------------------------------
+
+// SplitQuoted splits s into fields separated by spaces, tabs, carriage
+// returns and newlines. Single or double quotes may appear anywhere in a
+// field: the quoted text, including any whitespace, is joined to the rest
+// of the field, so `a"b c"d` yields the single field "ab cd". A pair of
+// empty quotes yields one empty field. There is no escaping, inside or
+// outside quotes. An unterminated quote is an error.
+func SplitQuoted(s string) (fields []string, err error) {
+	var field []byte
+	var quote byte
+	inField := false
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		switch {
+		case quote != 0:
+			if c == quote {
+				quote = 0
+			} else {
+				field = append(field, c)
+			}
+		case c == '\'' || c == '"':
+			quote, inField = c, true
+		case c == ' ' || c == '\t' || c == '\n' || c == '\r':
+			if inField {
+				fields = append(fields, string(field))
+				field, inField = field[:0], false
+			}
+		default:
+			field = append(field, c)
+			inField = true
+		}
+	}
+	if quote != 0 {
+		return nil, fmt.Errorf("unterminated %c string", quote)
+	}
+	if inField {
+		fields = append(fields, string(field))
+	}
+	return
+}
+
+// -----------------------------------------------------------------------------
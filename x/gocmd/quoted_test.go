@@ -0,0 +1,53 @@
+/*
+ * Copyright (c) 2023 The GoPlus Authors (goplus.org). All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package gocmd
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSplitQuoted(t *testing.T) {
+	cases := []struct {
+		in   string
+		want []string
+		err  bool
+	}{
+		{in: "-static -L/opt/lib  -lfoo", want: []string{"-static", "-L/opt/lib", "-lfoo"}},
+		{in: "-X 'main.v=a b'", want: []string{"-X", "main.v=a b"}},
+		{in: "''", want: []string{""}},
+		{in: `a"b c"d`, want: []string{"ab cd"}},
+		{in: `-X "main.v`, err: true},
+		{in: "'", err: true},
+		{in: " \t\n\r ", want: nil},
+		{in: "", want: nil},
+	}
+	for _, c := range cases {
+		got, err := SplitQuoted(c.in)
+		if c.err {
+			if err == nil {
+				t.Errorf("SplitQuoted(%q): expected error, got %q", c.in, got)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("SplitQuoted(%q): %v", c.in, err)
+		} else if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("SplitQuoted(%q) = %q, want %q", c.in, got, c.want)
+		}
+	}
+}